@@ -0,0 +1,208 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package gateway
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+)
+
+// CompareResources performs a startup full sync of the APISIX resources
+// produced from Gateway API HTTPRoute/TLSRoute objects. TCPRoute/UDPRoute
+// are not translated by this controller at all yet (there is no
+// TCPRouteLister/UDPRouteLister wired into Provider, nor a
+// TranslateGatewayTCPRouteV1Alpha2/UDPRouteV1Alpha2 on the translator), so
+// they are not part of this sync either; once those exist this function
+// needs a matching pair of loops below. It recomputes the expected
+// route/upstream IDs by re-running the same translation every controlled
+// Gateway's routes go through - including the per-Listener hostname
+// intersection decision, so a route that live reconciliation would reject
+// for NoMatchingListenerHostname is not counted as expected here either -
+// lists what is actually present in APISIX, and deletes anything carrying
+// translation.ManagedByLabel that is no longer expected. This is the
+// Gateway API analogue of the reconciliation pkg/ingress/compare.go
+// performs for ApisixRoute/ApisixUpstream, which does not know about
+// Gateway API-owned objects.
+func (p *Provider) CompareResources(ctx context.Context) error {
+	expectedRoutes, expectedUpstreams, err := p.expectedResourceIDs()
+	if err != nil {
+		return err
+	}
+
+	cluster := p.apisix.Cluster(p.clusterName)
+
+	routes, err := cluster.Route().List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, route := range routes {
+		if route.Labels[translation.ManagedByLabel] != "true" {
+			continue
+		}
+		if _, ok := expectedRoutes[route.ID]; ok {
+			continue
+		}
+		log.Infow("removing stray Gateway API route not backed by any CR",
+			zap.String("id", route.ID),
+		)
+		if err := cluster.Route().Delete(ctx, route); err != nil {
+			log.Errorw("failed to remove stray Gateway API route",
+				zap.String("id", route.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	upstreams, err := cluster.Upstream().List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ups := range upstreams {
+		if ups.Labels[translation.ManagedByLabel] != "true" {
+			continue
+		}
+		if _, ok := expectedUpstreams[ups.ID]; ok {
+			continue
+		}
+		log.Infow("removing stray Gateway API upstream not backed by any CR",
+			zap.String("id", ups.ID),
+		)
+		if err := cluster.Upstream().Delete(ctx, ups); err != nil {
+			log.Errorw("failed to remove stray Gateway API upstream",
+				zap.String("id", ups.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// expectedResourceIDs walks every HTTPRoute/TLSRoute attached to a Gateway of
+// the controlled GatewayClass, translates it the same way the admission
+// path does, and collects the route/upstream IDs that translation produced.
+func (p *Provider) expectedResourceIDs() (map[string]struct{}, map[string]struct{}, error) {
+	routeIDs := map[string]struct{}{}
+	upstreamIDs := map[string]struct{}{}
+
+	gateways, err := p.gatewayLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	controlledGateways := map[string]*gatewayv1alpha2.Gateway{}
+	for _, gw := range gateways {
+		if string(gw.Spec.GatewayClassName) != p.gatewayClassName {
+			continue
+		}
+		controlledGateways[gw.Namespace+"/"+gw.Name] = gw
+	}
+
+	collect := func(tc *translation.TranslateContext) {
+		for _, route := range tc.Routes() {
+			routeIDs[route.ID] = struct{}{}
+		}
+		for _, ups := range tc.Upstreams() {
+			upstreamIDs[ups.ID] = struct{}{}
+		}
+	}
+
+	httpRoutes, err := p.httpRouteLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, hr := range httpRoutes {
+		for _, parent := range hr.Spec.ParentRefs {
+			ns := hr.Namespace
+			if parent.Namespace != nil {
+				ns = string(*parent.Namespace)
+			}
+			gw, ok := controlledGateways[ns+"/"+string(parent.Name)]
+			if !ok {
+				continue
+			}
+			for _, listenerHostname := range matchingListenerHostnames(gw, parent.SectionName) {
+				tc, _, err := p.translator.TranslateGatewayHTTPRouteV1Alpha2(hr, listenerHostname)
+				if err != nil {
+					log.Warnw("failed to translate HTTPRoute during startup full sync, leaving its APISIX resources alone",
+						zap.String("httproute", hr.Namespace+"/"+hr.Name),
+						zap.Error(err),
+					)
+					continue
+				}
+				collect(tc)
+			}
+		}
+	}
+
+	tlsRoutes, err := p.tlsRouteLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tr := range tlsRoutes {
+		for _, parent := range tr.Spec.ParentRefs {
+			ns := tr.Namespace
+			if parent.Namespace != nil {
+				ns = string(*parent.Namespace)
+			}
+			gw, ok := controlledGateways[ns+"/"+string(parent.Name)]
+			if !ok {
+				continue
+			}
+			for _, listenerHostname := range matchingListenerHostnames(gw, parent.SectionName) {
+				tc, _, err := p.translator.TranslateGatewayTLSRouteV1Alpha2(tr, listenerHostname)
+				if err != nil {
+					log.Warnw("failed to translate TLSRoute during startup full sync, leaving its APISIX resources alone",
+						zap.String("tlsroute", tr.Namespace+"/"+tr.Name),
+						zap.Error(err),
+					)
+					continue
+				}
+				collect(tc)
+			}
+		}
+	}
+
+	return routeIDs, upstreamIDs, nil
+}
+
+// matchingListenerHostnames returns the Hostname of every Listener on gw
+// that a parentRef with the given SectionName would bind to (all Listeners
+// when SectionName is nil), so the caller can feed each one through the
+// translator and reproduce the exact per-Listener hostname-intersection
+// acceptance decision live reconciliation makes - rather than passing nil
+// and silently bypassing it. A Gateway with no matching Listener at all
+// (e.g. a stale SectionName) yields no hostnames and so contributes nothing
+// to the expected set, matching how live reconciliation would never attach
+// the route to any Listener either.
+func matchingListenerHostnames(gw *gatewayv1alpha2.Gateway, sectionName *gatewayv1alpha2.SectionName) []*gatewayv1alpha2.Hostname {
+	var hostnames []*gatewayv1alpha2.Hostname
+	for i := range gw.Spec.Listeners {
+		listener := gw.Spec.Listeners[i]
+		if sectionName != nil && listener.Name != *sectionName {
+			continue
+		}
+		hostnames = append(hostnames, listener.Hostname)
+	}
+	return hostnames
+}
@@ -0,0 +1,56 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestMatchingListenerHostnames(t *testing.T) {
+	httpHostname := gatewayv1alpha2.Hostname("http.example.com")
+	httpsHostname := gatewayv1alpha2.Hostname("https.example.com")
+
+	gw := &gatewayv1alpha2.Gateway{
+		Spec: gatewayv1alpha2.GatewaySpec{
+			Listeners: []gatewayv1alpha2.Listener{
+				{Name: "http", Hostname: &httpHostname},
+				{Name: "https", Hostname: &httpsHostname},
+				{Name: "no-hostname"},
+			},
+		},
+	}
+
+	t.Run("nil SectionName returns every Listener's hostname", func(t *testing.T) {
+		got := matchingListenerHostnames(gw, nil)
+		assert.ElementsMatch(t, []*gatewayv1alpha2.Hostname{&httpHostname, &httpsHostname, nil}, got)
+	})
+
+	t.Run("SectionName narrows to the matching Listener", func(t *testing.T) {
+		section := gatewayv1alpha2.SectionName("https")
+		got := matchingListenerHostnames(gw, &section)
+		assert.Equal(t, []*gatewayv1alpha2.Hostname{&httpsHostname}, got)
+	})
+
+	t.Run("unknown SectionName matches no Listener", func(t *testing.T) {
+		section := gatewayv1alpha2.SectionName("does-not-exist")
+		got := matchingListenerHostnames(gw, &section)
+		assert.Empty(t, got)
+	})
+}
@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package gateway
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+	gatewaylisters "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1alpha2"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+)
+
+// Provider wires Gateway API objects (GatewayClass/Gateway/HTTPRoute/
+// TLSRoute) through the translator and into APISIX. TCPRoute and UDPRoute
+// are not handled.
+type Provider struct {
+	translator    translation.Translator
+	apisix        apisix.APISIX
+	gatewayClient gatewayclientset.Interface
+	clusterName   string
+
+	// controllerName is reported back on RouteParentStatus.ControllerName
+	// when a route's status is updated.
+	controllerName string
+
+	// gatewayClassName is the GatewayClass this controller reconciles
+	// Gateways for; only routes attached to a Gateway of this class are
+	// translated and accounted for by CompareResources.
+	gatewayClassName string
+
+	gatewayLister   gatewaylisters.GatewayLister
+	httpRouteLister gatewaylisters.HTTPRouteLister
+	tlsRouteLister  gatewaylisters.TLSRouteLister
+}
+
+// Init performs a startup full sync, removing any APISIX resource produced
+// from a Gateway API route that is no longer backed by a CR.
+func (p *Provider) Init(ctx context.Context) error {
+	if err := p.CompareResources(ctx); err != nil {
+		log.Errorw("failed to compare Gateway API resources on startup",
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+)
+
+// SyncHTTPRoute translates httpRoute for the given parent Listener hostname
+// and reports the outcome on the route's status before handing the
+// resulting TranslateContext back to the caller to push into APISIX. The
+// report is written to status even when the translator returns a hard
+// error (e.g. a rule-level filter that fails to translate), so the
+// backendRef failures recorded before it are still visible on the route
+// rather than only logged; the hard error itself is returned to the caller
+// and is not added to the report. A status update failure is itself
+// only logged rather than returned, so a transient status-write error does
+// not block the resource sync.
+func (p *Provider) SyncHTTPRoute(ctx context.Context, httpRoute *gatewayv1alpha2.HTTPRoute, listenerHostname *gatewayv1alpha2.Hostname) (*translation.TranslateContext, error) {
+	tc, report, err := p.translator.TranslateGatewayHTTPRouteV1Alpha2(httpRoute, listenerHostname)
+	if report != nil {
+		if statusErr := p.updateHTTPRouteStatus(ctx, httpRoute, report); statusErr != nil {
+			log.Errorw("failed to update HTTPRoute status",
+				zap.String("httproute", httpRoute.Namespace+"/"+httpRoute.Name),
+				zap.Error(statusErr),
+			)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tc, nil
+}
+
+const (
+	_conditionAccepted     = "Accepted"
+	_conditionResolvedRefs = "ResolvedRefs"
+)
+
+// updateHTTPRouteStatus turns a RouteTranslateReport into Accepted/
+// ResolvedRefs conditions on every one of httpRoute's parentRefs and
+// persists them. Until now, an unresolvable BackendRef, a non-Service kind,
+// a missing port, or an empty rule was only logged, leaving the user with no
+// signal that their route was not doing what they expected.
+func (p *Provider) updateHTTPRouteStatus(ctx context.Context, httpRoute *gatewayv1alpha2.HTTPRoute, report *translation.RouteTranslateReport) error {
+	conditions := routeStatusConditions(httpRoute.Generation, report)
+
+	updated := httpRoute.DeepCopy()
+	updated.Status.Parents = make([]gatewayv1alpha2.RouteParentStatus, 0, len(httpRoute.Spec.ParentRefs))
+	for _, parent := range httpRoute.Spec.ParentRefs {
+		updated.Status.Parents = append(updated.Status.Parents, gatewayv1alpha2.RouteParentStatus{
+			ParentRef:      parent,
+			ControllerName: gatewayv1alpha2.GatewayController(p.controllerName),
+			Conditions:     conditions,
+		})
+	}
+
+	_, err := p.gatewayClient.GatewayV1alpha2().HTTPRoutes(httpRoute.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// routeStatusConditions builds the Accepted/ResolvedRefs condition pair from
+// a translation report. ResolvedRefs turns False as soon as any backendRef
+// failed, using that failure's reason (InvalidKind, BackendNotFound,
+// RefNotPermitted, UnsupportedValue); Accepted turns False once every rule
+// in the route has failed, or when none of the route's hostnames intersect
+// the Listener's hostname.
+func routeStatusConditions(generation int64, report *translation.RouteTranslateReport) []metav1.Condition {
+	resolvedRefs := metav1.Condition{
+		Type:               _conditionResolvedRefs,
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionTrue,
+		Reason:             _conditionResolvedRefs,
+		Message:            "all backendRefs were resolved",
+	}
+	if !report.ResolvedRefs() {
+		failure := report.BackendFailures[0]
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(failure.Reason)
+		resolvedRefs.Message = fmt.Sprintf("%d backendRef(s) failed to resolve, e.g. Rules[%d].BackendRefs[%d]: %s",
+			len(report.BackendFailures), failure.RuleIndex, failure.BackendRefIndex, failure.Message)
+	}
+
+	accepted := metav1.Condition{
+		Type:               _conditionAccepted,
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionTrue,
+		Reason:             _conditionAccepted,
+		Message:            "route accepted",
+	}
+	if !report.Accepted() {
+		accepted.Status = metav1.ConditionFalse
+		if report.HostnameMismatch {
+			accepted.Reason = string(translation.ReasonNoMatchingListenerHostname)
+			accepted.Message = "no intersecting hostnames between the Listener and the route"
+		} else {
+			accepted.Reason = "NoMatchingParent"
+			accepted.Message = fmt.Sprintf("all %d rule(s) failed to resolve a backend", report.RuleCount)
+		}
+	}
+
+	return []metav1.Condition{accepted, resolvedRefs}
+}
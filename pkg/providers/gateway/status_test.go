@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+)
+
+func conditionByType(conditions []metav1.Condition, conditionType string) metav1.Condition {
+	for _, c := range conditions {
+		if c.Type == conditionType {
+			return c
+		}
+	}
+	return metav1.Condition{}
+}
+
+func TestRouteStatusConditions(t *testing.T) {
+	t.Run("fully resolved and accepted", func(t *testing.T) {
+		conditions := routeStatusConditions(1, &translation.RouteTranslateReport{RuleCount: 1, AcceptedRules: 1})
+		assert.Equal(t, metav1.ConditionTrue, conditionByType(conditions, _conditionAccepted).Status)
+		assert.Equal(t, metav1.ConditionTrue, conditionByType(conditions, _conditionResolvedRefs).Status)
+	})
+
+	t.Run("backend failure turns ResolvedRefs false with its reason", func(t *testing.T) {
+		report := &translation.RouteTranslateReport{RuleCount: 1, AcceptedRules: 1}
+		report.BackendFailures = append(report.BackendFailures, translation.BackendRefFailure{
+			Reason:  translation.ReasonBackendNotFound,
+			Message: "boom",
+		})
+		conditions := routeStatusConditions(1, report)
+		resolvedRefs := conditionByType(conditions, _conditionResolvedRefs)
+		assert.Equal(t, metav1.ConditionFalse, resolvedRefs.Status)
+		assert.Equal(t, string(translation.ReasonBackendNotFound), resolvedRefs.Reason)
+	})
+
+	t.Run("all rules failed turns Accepted false with NoMatchingParent", func(t *testing.T) {
+		conditions := routeStatusConditions(1, &translation.RouteTranslateReport{RuleCount: 1, AcceptedRules: 0})
+		accepted := conditionByType(conditions, _conditionAccepted)
+		assert.Equal(t, metav1.ConditionFalse, accepted.Status)
+		assert.Equal(t, "NoMatchingParent", accepted.Reason)
+	})
+
+	t.Run("hostname mismatch turns Accepted false with NoMatchingListenerHostname", func(t *testing.T) {
+		conditions := routeStatusConditions(1, &translation.RouteTranslateReport{HostnameMismatch: true})
+		accepted := conditionByType(conditions, _conditionAccepted)
+		assert.Equal(t, metav1.ConditionFalse, accepted.Status)
+		assert.Equal(t, string(translation.ReasonNoMatchingListenerHostname), accepted.Reason)
+	})
+}
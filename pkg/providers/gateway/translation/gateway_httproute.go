@@ -32,19 +32,31 @@ import (
 	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
-func (t *translator) generatePluginsFromHTTPRouteFilter(filters []gatewayv1alpha2.HTTPRouteFilter) apisixv1.Plugins {
+func (t *translator) generatePluginsFromHTTPRouteFilter(ctx *translation.TranslateContext, namespace string, ruleIndex int, filters []gatewayv1alpha2.HTTPRouteFilter) (apisixv1.Plugins, error) {
 	plugins := apisixv1.Plugins{}
-	for _, filter := range filters {
+	for i, filter := range filters {
 		switch filter.Type {
 		case gatewayv1alpha2.HTTPRouteFilterRequestHeaderModifier:
 			t.generatePluginFromHTTPRequestHeaderFilter(plugins, filter.RequestHeaderModifier)
 		case gatewayv1alpha2.HTTPRouteFilterRequestRedirect:
 			t.generatePluginFromHTTPRequestRedirectFilter(plugins, filter.RequestRedirect)
 		case gatewayv1alpha2.HTTPRouteFilterRequestMirror:
-			// to do
+			if err := t.generatePluginFromHTTPRequestMirrorFilter(ctx, namespace, plugins, filter.RequestMirror); err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].Filters[%v]", ruleIndex, i))
+			}
 		}
 	}
-	return plugins
+	return plugins, nil
+}
+
+// mergeSingleBackendPlugins merges a single backend's own filter-derived
+// plugins into routePlugins (the rule-level plugins), with the
+// backend-level entry taking precedence on a name collision, per the
+// Gateway API BackendRef filter spec.
+func mergeSingleBackendPlugins(routePlugins apisixv1.Plugins, backendPlugins apisixv1.Plugins) {
+	for pluginName, pluginConfig := range backendPlugins {
+		routePlugins[pluginName] = pluginConfig
+	}
 }
 
 func (t *translator) generatePluginFromHTTPRequestHeaderFilter(plugins apisixv1.Plugins, reqHeaderModifier *gatewayv1alpha2.HTTPRequestHeaderFilter) {
@@ -102,27 +114,98 @@ func (t *translator) generatePluginFromHTTPRequestRedirectFilter(plugins apisixv
 	}
 }
 
-func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha2.HTTPRoute) (*translation.TranslateContext, error) {
-	ctx := translation.DefaultEmptyTranslateContext()
+func (t *translator) generatePluginFromHTTPRequestMirrorFilter(ctx *translation.TranslateContext, namespace string, plugins apisixv1.Plugins, reqMirror *gatewayv1alpha2.HTTPRequestMirrorFilter) error {
+	if reqMirror == nil {
+		return nil
+	}
+
+	backend := reqMirror.BackendRef
+
+	var kind string
+	if backend.Kind == nil {
+		kind = "service"
+	} else {
+		kind = strings.ToLower(string(*backend.Kind))
+	}
+	if kind != "service" {
+		return errors.New("unsupported BackendRef kind " + kind + " in RequestMirror filter")
+	}
+
+	ns := namespace
+	if backend.Namespace != nil {
+		ns = string(*backend.Namespace)
+	}
+	if !t.referencePermitted(namespace, _httpRouteKind, ns, string(backend.Name)) {
+		return errors.New("no ReferencePolicy permits this RequestMirror filter's cross-namespace BackendRef")
+	}
+
+	if backend.Port == nil {
+		return errors.New("missing port in RequestMirror filter BackendRef")
+	}
+
+	ups, err := t.KubeTranslator.TranslateService(ns, string(backend.Name), "", int32(*backend.Port))
+	if err != nil {
+		return errors.Wrap(err, "failed to translate RequestMirror filter BackendRef")
+	}
+	name := apisixv1.ComposeUpstreamName(ns, string(backend.Name), "", int32(*backend.Port), types.ResolveGranularity.Endpoint)
 
-	var hosts []string
-	for _, hostname := range httpRoute.Spec.Hostnames {
-		hosts = append(hosts, string(hostname))
-
-		// TODO: See the document of gatewayv1alpha2.Listener.Hostname
-		_ = gatewayv1alpha2.Listener{}.Hostname
-		// For HTTPRoute and TLSRoute resources, there is an interaction with the
-		// `spec.hostnames` array. When both listener and route specify hostnames,
-		// there MUST be an intersection between the values for a Route to be
-		// accepted. For more information, refer to the Route specific Hostnames
-		// documentation.
+	// APISIX limits max length of label value
+	// https://github.com/apache/apisix/blob/5b95b85faea3094d5e466ee2d39a52f1f805abbb/apisix/schema_def.lua#L85
+	ups.Labels["meta_namespace"] = utils.TruncateString(ns, 64)
+	ups.Labels["meta_backend"] = utils.TruncateString(string(backend.Name), 64)
+	ups.Labels["meta_port"] = fmt.Sprintf("%v", int32(*backend.Port))
+	ups.Labels[ManagedByLabel] = "true"
+
+	ups.ID = id.GenID(name)
+	ctx.AddUpstream(ups)
+
+	if len(ups.Nodes) == 0 {
+		return errors.New("mirrored backend has no reachable endpoints")
+	}
+
+	// The proxy-mirror plugin only accepts a single target host, so unlike the
+	// primary BackendRefs loop, multiple endpoints cannot be weighted here;
+	// the first resolved node is used as the mirror target.
+	plugins["proxy-mirror"] = &apisixv1.MirrorConfig{
+		Host:        fmt.Sprintf("http://%s:%d", ups.Nodes[0].Host, ups.Nodes[0].Port),
+		SampleRatio: 1,
+	}
+
+	return nil
+}
+
+// TranslateGatewayHTTPRouteV1Alpha2 translates an HTTPRoute into APISIX
+// resources. listenerHostname is the hostname of the parent Gateway Listener
+// this route is being translated for; per the Gateway API spec, it MUST
+// intersect with the route's spec.hostnames for the route to be accepted.
+// When the intersection is empty, the route is rejected and an empty
+// TranslateContext is returned so the caller can surface
+// Accepted=False/NoMatchingListenerHostname on the route status.
+//
+// The returned RouteTranslateReport lists the per-rule/per-BackendRef
+// resolution failures, if any, so the caller can set ResolvedRefs/Accepted
+// conditions on the route status instead of the failures only being logged.
+func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha2.HTTPRoute, listenerHostname *gatewayv1alpha2.Hostname) (*translation.TranslateContext, *RouteTranslateReport, error) {
+	ctx := translation.DefaultEmptyTranslateContext()
+	report := &RouteTranslateReport{}
+
+	hosts := intersectHostnames(httpRoute.Spec.Hostnames, listenerHostname)
+	if len(hosts) == 0 && (len(httpRoute.Spec.Hostnames) > 0 || (listenerHostname != nil && *listenerHostname != "")) {
+		log.Warnw("no intersecting hostnames between Listener and HTTPRoute, rejecting route",
+			zap.String("httproute", httpRoute.Namespace+"/"+httpRoute.Name),
+			zap.String("reason", "NoMatchingListenerHostname"),
+		)
+		report.HostnameMismatch = true
+		return ctx, report, nil
 	}
 
 	rules := httpRoute.Spec.Rules
 
 	for i, rule := range rules {
 		backends := rule.BackendRefs
+		report.RuleCount++
 		if len(backends) == 0 {
+			report.addBackendFailure(i, -1, ReasonUnsupportedValue, "rule has no backendRefs")
 			continue
 		}
 
@@ -130,8 +213,6 @@ func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha
 		var weightedUpstreams []apisixv1.TrafficSplitConfigRuleWeightedUpstream
 
 		for j, backend := range backends {
-			//TODO: Support filters
-			//filters := backend.Filters
 			var kind string
 			if backend.Kind == nil {
 				kind = "service"
@@ -142,6 +223,7 @@ func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha
 				log.Warnw(fmt.Sprintf("ignore non-service kind at Rules[%v].BackendRefs[%v]", i, j),
 					zap.String("kind", kind),
 				)
+				report.addBackendFailure(i, j, ReasonInvalidKind, fmt.Sprintf("unsupported BackendRef kind %q", kind))
 				continue
 			}
 
@@ -151,20 +233,29 @@ func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha
 			} else {
 				ns = string(*backend.Namespace)
 			}
-			//if ns != httpRoute.Namespace {
-			// TODO: check gatewayv1alpha2.ReferencePolicy
-			//}
+			if !t.referencePermitted(httpRoute.Namespace, _httpRouteKind, ns, string(backend.Name)) {
+				log.Warnw(fmt.Sprintf("ignore not-permitted cross-namespace backend ref at Rules[%v].BackendRefs[%v]", i, j),
+					zap.String("namespace", ns),
+				)
+				report.addBackendFailure(i, j, ReasonRefNotPermitted, fmt.Sprintf("no ReferencePolicy permits HTTPRoute %s/%s to reference Service %s/%s", httpRoute.Namespace, httpRoute.Name, ns, backend.Name))
+				continue
+			}
 
 			if backend.Port == nil {
 				log.Warnw(fmt.Sprintf("ignore nil port at Rules[%v].BackendRefs[%v]", i, j),
 					zap.String("kind", kind),
 				)
+				report.addBackendFailure(i, j, ReasonUnsupportedValue, "missing port")
 				continue
 			}
 
 			ups, err := t.KubeTranslator.TranslateService(ns, string(backend.Name), "", int32(*backend.Port))
 			if err != nil {
-				return nil, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].BackendRefs[%v]", i, j))
+				log.Warnw(fmt.Sprintf("ignore unresolvable backend ref at Rules[%v].BackendRefs[%v]", i, j),
+					zap.Error(err),
+				)
+				report.addBackendFailure(i, j, ReasonBackendNotFound, err.Error())
+				continue
 			}
 			name := apisixv1.ComposeUpstreamName(ns, string(backend.Name), "", int32(*backend.Port), types.ResolveGranularity.Endpoint)
 
@@ -173,22 +264,44 @@ func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha
 			ups.Labels["meta_namespace"] = utils.TruncateString(ns, 64)
 			ups.Labels["meta_backend"] = utils.TruncateString(string(backend.Name), 64)
 			ups.Labels["meta_port"] = fmt.Sprintf("%v", int32(*backend.Port))
+			ups.Labels[ManagedByLabel] = "true"
 
 			ups.ID = id.GenID(name)
+
+			// Per-backend filters (RequestHeaderModifier, RequestRedirect,
+			// RequestMirror) only apply to traffic sent to this backend, so they
+			// are kept alongside its weighted upstream entry rather than folded
+			// into the rule-level plugins. Nested BackendRefs (e.g. a
+			// RequestMirror target) default to and are permission-checked
+			// against the HTTPRoute's own namespace, not this backend's
+			// resolved namespace. A bad mirror target (missing Service, no
+			// endpoints, not permitted, ...) only drops this one backendRef,
+			// the same as a bad primary BackendRef above - it must not abort
+			// translation of the whole route.
+			backendPlugins, err := t.generatePluginsFromHTTPRouteFilter(ctx, httpRoute.Namespace, i, backend.Filters)
+			if err != nil {
+				log.Warnw(fmt.Sprintf("ignore backend ref with failing filter at Rules[%v].BackendRefs[%v]", i, j),
+					zap.Error(err),
+				)
+				report.addBackendFailure(i, j, ReasonUnsupportedValue, err.Error())
+				continue
+			}
+
 			ctx.AddUpstream(ups)
 			ruleUpstreams = append(ruleUpstreams, ups)
 
-			if backend.Weight == nil {
-				weightedUpstreams = append(weightedUpstreams, apisixv1.TrafficSplitConfigRuleWeightedUpstream{
-					UpstreamID: ups.ID,
-					Weight:     1, // 1 is default value of BackendRef
-				})
-			} else {
-				weightedUpstreams = append(weightedUpstreams, apisixv1.TrafficSplitConfigRuleWeightedUpstream{
-					UpstreamID: ups.ID,
-					Weight:     int(*backend.Weight),
-				})
+			weight := 1 // 1 is default value of BackendRef
+			if backend.Weight != nil {
+				weight = int(*backend.Weight)
 			}
+			weightedUpstream := apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+				UpstreamID: ups.ID,
+				Weight:     weight,
+			}
+			if len(backendPlugins) > 0 {
+				weightedUpstream.Plugins = backendPlugins
+			}
+			weightedUpstreams = append(weightedUpstreams, weightedUpstream)
 		}
 		if len(ruleUpstreams) == 0 {
 			log.Warnw(fmt.Sprintf("ignore all-failed backend refs at Rules[%v]", i),
@@ -210,22 +323,34 @@ func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha
 				},
 			}
 		}
-		plugins := t.generatePluginsFromHTTPRouteFilter(rule.Filters)
+		plugins, err := t.generatePluginsFromHTTPRouteFilter(ctx, httpRoute.Namespace, i, rule.Filters)
+		if err != nil {
+			return nil, report, err
+		}
+		report.AcceptedRules++
 
 		for j, match := range matches {
 			route, err := t.translateGatewayHTTPRouteMatch(&match)
 			if err != nil {
-				return nil, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].Matches[%v]", i, j))
+				return nil, report, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].Matches[%v]", i, j))
 			}
 
 			name := apisixv1.ComposeRouteName(httpRoute.Namespace, httpRoute.Name, fmt.Sprintf("%d-%d", i, j))
 			route.ID = id.GenID(name)
 			route.Hosts = hosts
 			route.Plugins = plugins
+			if route.Labels == nil {
+				route.Labels = make(map[string]string)
+			}
+			route.Labels[ManagedByLabel] = "true"
 
 			// Bind Upstream
 			if len(ruleUpstreams) == 1 {
 				route.UpstreamId = ruleUpstreams[0].ID
+				// A single backend has no traffic-split to carry its filters, so
+				// merge them into the rule-level plugins here, with backend-level
+				// filters taking precedence per the Gateway API spec.
+				mergeSingleBackendPlugins(route.Plugins, weightedUpstreams[0].Plugins)
 			} else if len(ruleUpstreams) > 0 {
 				route.Plugins["traffic-split"] = &apisixv1.TrafficSplitConfig{
 					Rules: []apisixv1.TrafficSplitConfigRule{
@@ -238,12 +363,9 @@ func (t *translator) TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha
 
 			ctx.AddRoute(route)
 		}
-
-		//TODO: Support filters
-		//filters := rule.Filters
 	}
 
-	return ctx, nil
+	return ctx, report, nil
 }
 
 func (t *translator) translateGatewayHTTPRouteMatch(match *gatewayv1alpha2.HTTPRouteMatch) (*apisixv1.Route, error) {
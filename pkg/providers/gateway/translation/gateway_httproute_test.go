@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestMergeSingleBackendPlugins(t *testing.T) {
+	t.Run("backend-level plugin wins on name collision", func(t *testing.T) {
+		routePlugins := apisixv1.Plugins{
+			"proxy-rewrite": apisixv1.RewriteConfig{Headers: map[string]any{"x-rule": "rule"}},
+		}
+		backendPlugins := apisixv1.Plugins{
+			"proxy-rewrite": apisixv1.RewriteConfig{Headers: map[string]any{"x-backend": "backend"}},
+		}
+
+		mergeSingleBackendPlugins(routePlugins, backendPlugins)
+
+		assert.Equal(t, backendPlugins["proxy-rewrite"], routePlugins["proxy-rewrite"])
+	})
+
+	t.Run("non-colliding plugins from both levels are kept", func(t *testing.T) {
+		routePlugins := apisixv1.Plugins{
+			"redirect": apisixv1.RedirectConfig{RetCode: 302},
+		}
+		backendPlugins := apisixv1.Plugins{
+			"proxy-mirror": &apisixv1.MirrorConfig{Host: "http://mirror"},
+		}
+
+		mergeSingleBackendPlugins(routePlugins, backendPlugins)
+
+		assert.Contains(t, routePlugins, "redirect")
+		assert.Contains(t, routePlugins, "proxy-mirror")
+	})
+
+	t.Run("empty backend plugins leaves route plugins untouched", func(t *testing.T) {
+		routePlugins := apisixv1.Plugins{
+			"redirect": apisixv1.RedirectConfig{RetCode: 302},
+		}
+
+		mergeSingleBackendPlugins(routePlugins, apisixv1.Plugins{})
+
+		assert.Len(t, routePlugins, 1)
+	})
+}
@@ -0,0 +1,154 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+// TranslateGatewayTLSRouteV1Alpha2 translates a TLSRoute into APISIX
+// resources. listenerHostname is the hostname of the parent Gateway Listener
+// this route is being translated for; as with HTTPRoute, it MUST intersect
+// with the route's spec.hostnames for the route to be accepted. See
+// TranslateGatewayHTTPRouteV1Alpha2 for what the returned RouteTranslateReport
+// is for.
+func (t *translator) TranslateGatewayTLSRouteV1Alpha2(tlsRoute *gatewayv1alpha2.TLSRoute, listenerHostname *gatewayv1alpha2.Hostname) (*translation.TranslateContext, *RouteTranslateReport, error) {
+	ctx := translation.DefaultEmptyTranslateContext()
+	report := &RouteTranslateReport{}
+
+	hosts := intersectHostnames(tlsRoute.Spec.Hostnames, listenerHostname)
+	if len(hosts) == 0 && (len(tlsRoute.Spec.Hostnames) > 0 || (listenerHostname != nil && *listenerHostname != "")) {
+		log.Warnw("no intersecting hostnames between Listener and TLSRoute, rejecting route",
+			zap.String("tlsroute", tlsRoute.Namespace+"/"+tlsRoute.Name),
+			zap.String("reason", "NoMatchingListenerHostname"),
+		)
+		report.HostnameMismatch = true
+		return ctx, report, nil
+	}
+
+	for i, rule := range tlsRoute.Spec.Rules {
+		report.RuleCount++
+		var ruleUpstreams []*apisixv1.Upstream
+		var weightedUpstreams []apisixv1.TrafficSplitConfigRuleWeightedUpstream
+
+		for j, backend := range rule.BackendRefs {
+			var kind string
+			if backend.Kind == nil {
+				kind = "service"
+			} else {
+				kind = string(*backend.Kind)
+			}
+			if kind != "Service" && kind != "service" {
+				log.Warnw(fmt.Sprintf("ignore non-service kind at Rules[%v].BackendRefs[%v]", i, j),
+					zap.String("kind", kind),
+				)
+				report.addBackendFailure(i, j, ReasonInvalidKind, fmt.Sprintf("unsupported BackendRef kind %q", kind))
+				continue
+			}
+
+			ns := tlsRoute.Namespace
+			if backend.Namespace != nil {
+				ns = string(*backend.Namespace)
+			}
+			if !t.referencePermitted(tlsRoute.Namespace, _tlsRouteKind, ns, string(backend.Name)) {
+				log.Warnw(fmt.Sprintf("ignore not-permitted cross-namespace backend ref at Rules[%v].BackendRefs[%v]", i, j),
+					zap.String("namespace", ns),
+				)
+				report.addBackendFailure(i, j, ReasonRefNotPermitted, fmt.Sprintf("no ReferencePolicy permits TLSRoute %s/%s to reference Service %s/%s", tlsRoute.Namespace, tlsRoute.Name, ns, backend.Name))
+				continue
+			}
+
+			if backend.Port == nil {
+				log.Warnw(fmt.Sprintf("ignore nil port at Rules[%v].BackendRefs[%v]", i, j),
+					zap.String("kind", kind),
+				)
+				report.addBackendFailure(i, j, ReasonUnsupportedValue, "missing port")
+				continue
+			}
+
+			ups, err := t.KubeTranslator.TranslateService(ns, string(backend.Name), "", int32(*backend.Port))
+			if err != nil {
+				report.addBackendFailure(i, j, ReasonBackendNotFound, err.Error())
+				return nil, report, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].BackendRefs[%v]", i, j))
+			}
+			name := apisixv1.ComposeUpstreamName(ns, string(backend.Name), "", int32(*backend.Port), types.ResolveGranularity.Endpoint)
+
+			ups.Labels["meta_namespace"] = utils.TruncateString(ns, 64)
+			ups.Labels["meta_backend"] = utils.TruncateString(string(backend.Name), 64)
+			ups.Labels["meta_port"] = fmt.Sprintf("%v", int32(*backend.Port))
+			ups.Labels[ManagedByLabel] = "true"
+
+			ups.ID = id.GenID(name)
+			ctx.AddUpstream(ups)
+			ruleUpstreams = append(ruleUpstreams, ups)
+
+			weight := 1
+			if backend.Weight != nil {
+				weight = int(*backend.Weight)
+			}
+			weightedUpstreams = append(weightedUpstreams, apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+				UpstreamID: ups.ID,
+				Weight:     weight,
+			})
+		}
+		if len(ruleUpstreams) == 0 {
+			log.Warnw(fmt.Sprintf("ignore all-failed backend refs at Rules[%v]", i),
+				zap.Any("BackendRefs", rule.BackendRefs),
+			)
+			continue
+		}
+
+		route := apisixv1.NewDefaultRoute()
+		name := apisixv1.ComposeRouteName(tlsRoute.Namespace, tlsRoute.Name, fmt.Sprintf("%d", i))
+		route.ID = id.GenID(name)
+		route.Hosts = hosts
+		if route.Labels == nil {
+			route.Labels = make(map[string]string)
+		}
+		route.Labels[ManagedByLabel] = "true"
+
+		if len(ruleUpstreams) == 1 {
+			route.UpstreamId = ruleUpstreams[0].ID
+		} else {
+			route.Plugins = apisixv1.Plugins{
+				"traffic-split": &apisixv1.TrafficSplitConfig{
+					Rules: []apisixv1.TrafficSplitConfigRule{
+						{
+							WeightedUpstreams: weightedUpstreams,
+						},
+					},
+				},
+			}
+		}
+
+		ctx.AddRoute(route)
+		report.AcceptedRules++
+	}
+
+	return ctx, report, nil
+}
@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"strings"
+
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// intersectHostnames computes the hostnames a route is actually accepted
+// for, per the Gateway API rule that a route's spec.hostnames and its parent
+// Listener's hostname MUST intersect. A nil or empty listener hostname
+// matches every route hostname (and, when the route specifies none either,
+// yields no restriction). When only one side specifies hostnames, the other
+// side is treated as "match all". Shared by the HTTPRoute and TLSRoute
+// translators, which both have this requirement.
+func intersectHostnames(routeHostnames []gatewayv1alpha2.Hostname, listenerHostname *gatewayv1alpha2.Hostname) []string {
+	if listenerHostname == nil || *listenerHostname == "" {
+		hosts := make([]string, 0, len(routeHostnames))
+		for _, h := range routeHostnames {
+			hosts = append(hosts, string(h))
+		}
+		return hosts
+	}
+
+	listener := string(*listenerHostname)
+	if len(routeHostnames) == 0 {
+		return []string{listener}
+	}
+
+	var hosts []string
+	for _, h := range routeHostnames {
+		route := string(h)
+		if !hostnamesIntersect(route, listener) {
+			continue
+		}
+		// Prefer the more specific (non-wildcard) side as the effective host.
+		if strings.HasPrefix(route, "*.") && !strings.HasPrefix(listener, "*.") {
+			hosts = append(hosts, listener)
+		} else {
+			hosts = append(hosts, route)
+		}
+	}
+	return hosts
+}
+
+// hostnamesIntersect reports whether a and b overlap, where either may carry
+// a single leading wildcard label, e.g. "*.example.com" matches
+// "foo.example.com".
+func hostnamesIntersect(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	aWildcard := strings.HasPrefix(a, "*.")
+	bWildcard := strings.HasPrefix(b, "*.")
+
+	switch {
+	case aWildcard && !bWildcard:
+		return wildcardMatches(a, b)
+	case bWildcard && !aWildcard:
+		return wildcardMatches(b, a)
+	case aWildcard && bWildcard:
+		return strings.TrimPrefix(a, "*.") == strings.TrimPrefix(b, "*.")
+	default:
+		return false
+	}
+}
+
+// wildcardMatches reports whether other is covered by the single leading
+// wildcard label of wildcard, e.g. "*.example.com" matches "foo.example.com"
+// but not "foo.bar.example.com" - per the Gateway API spec a wildcard
+// replaces exactly one DNS label, not an arbitrary number of them.
+func wildcardMatches(wildcard, other string) bool {
+	suffix := strings.TrimPrefix(wildcard, "*")
+	if !strings.HasSuffix(other, suffix) {
+		return false
+	}
+	label := strings.TrimSuffix(other, suffix)
+	return label != "" && !strings.Contains(label, ".")
+}
@@ -0,0 +1,78 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestHostnamesIntersect(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{"exact match", "foo.example.com", "foo.example.com", true},
+		{"no match", "foo.example.com", "bar.example.com", false},
+		{"wildcard matches single label", "*.example.com", "foo.example.com", true},
+		{"wildcard does not match multiple labels", "*.example.com", "foo.bar.example.com", false},
+		{"wildcard does not match zero labels", "*.example.com", "example.com", false},
+		{"reversed wildcard matches single label", "foo.example.com", "*.example.com", true},
+		{"both wildcard same suffix", "*.example.com", "*.example.com", true},
+		{"both wildcard different suffix", "*.example.com", "*.other.com", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, hostnamesIntersect(c.a, c.b))
+		})
+	}
+}
+
+func TestIntersectHostnames(t *testing.T) {
+	hostname := func(h string) *gatewayv1alpha2.Hostname {
+		v := gatewayv1alpha2.Hostname(h)
+		return &v
+	}
+
+	t.Run("nil listener hostname matches all route hostnames", func(t *testing.T) {
+		got := intersectHostnames([]gatewayv1alpha2.Hostname{"foo.example.com"}, nil)
+		assert.Equal(t, []string{"foo.example.com"}, got)
+	})
+
+	t.Run("no route hostnames takes the listener hostname", func(t *testing.T) {
+		got := intersectHostnames(nil, hostname("foo.example.com"))
+		assert.Equal(t, []string{"foo.example.com"}, got)
+	})
+
+	t.Run("wildcard listener does not match a multi-label route hostname", func(t *testing.T) {
+		got := intersectHostnames([]gatewayv1alpha2.Hostname{"foo.bar.example.com"}, hostname("*.example.com"))
+		assert.Empty(t, got)
+	})
+
+	t.Run("wildcard route hostname prefers the concrete listener hostname", func(t *testing.T) {
+		got := intersectHostnames([]gatewayv1alpha2.Hostname{"*.example.com"}, hostname("foo.example.com"))
+		assert.Equal(t, []string{"foo.example.com"}, got)
+	})
+
+	t.Run("disjoint hostnames yield no intersection", func(t *testing.T) {
+		got := intersectHostnames([]gatewayv1alpha2.Hostname{"foo.example.com"}, hostname("bar.example.com"))
+		assert.Empty(t, got)
+	})
+}
@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+)
+
+const (
+	_gatewayGroup  = "gateway.networking.k8s.io"
+	_coreGroup     = ""
+	_serviceKind   = "Service"
+	_httpRouteKind = "HTTPRoute"
+	_tlsRouteKind  = "TLSRoute"
+)
+
+// referencePermitted reports whether a BackendRef in fromNamespace is
+// allowed to reference a Service named toName in toNamespace. Same-namespace
+// references are always permitted; cross-namespace references require a
+// ReferencePolicy in toNamespace that grants access from fromNamespace/
+// fromKind to Service.
+func (t *translator) referencePermitted(fromNamespace, fromKind, toNamespace, toName string) bool {
+	if fromNamespace == toNamespace {
+		return true
+	}
+
+	policies, err := t.ReferencePolicyLister.ReferencePolicies(toNamespace).List(labels.Everything())
+	if err != nil {
+		log.Warnw("failed to list ReferencePolicy, denying cross-namespace reference",
+			zap.String("namespace", toNamespace),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	for _, policy := range policies {
+		for _, from := range policy.Spec.From {
+			if string(from.Namespace) != fromNamespace {
+				continue
+			}
+			if string(from.Group) != _gatewayGroup || string(from.Kind) != fromKind {
+				continue
+			}
+			for _, to := range policy.Spec.To {
+				if string(to.Group) != _coreGroup || string(to.Kind) != _serviceKind {
+					continue
+				}
+				if to.Name == nil || string(*to.Name) == toName {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+// RouteConditionReason mirrors the Gateway API's well-known reasons for the
+// ResolvedRefs/Accepted route conditions, so the provider can set them on
+// the route status without the translator leaking APISIX-specific detail.
+type RouteConditionReason string
+
+const (
+	// ReasonInvalidKind is used when a BackendRef names an unsupported kind.
+	ReasonInvalidKind RouteConditionReason = "InvalidKind"
+	// ReasonBackendNotFound is used when the referenced Service (or other
+	// backend) does not exist or could not be translated.
+	ReasonBackendNotFound RouteConditionReason = "BackendNotFound"
+	// ReasonRefNotPermitted is used when a cross-namespace BackendRef is not
+	// covered by a ReferencePolicy; ReferenceGrant objects are not consulted.
+	ReasonRefNotPermitted RouteConditionReason = "RefNotPermitted"
+	// ReasonUnsupportedValue is used for any other malformed field, e.g. a
+	// missing port.
+	ReasonUnsupportedValue RouteConditionReason = "UnsupportedValue"
+	// ReasonNoMatchingListenerHostname is used when none of the route's
+	// hostnames intersect the Listener's hostname.
+	ReasonNoMatchingListenerHostname RouteConditionReason = "NoMatchingListenerHostname"
+)
+
+// BackendRefFailure records why a single BackendRef inside a rule could not
+// be resolved.
+type BackendRefFailure struct {
+	RuleIndex       int
+	BackendRefIndex int
+	Reason          RouteConditionReason
+	Message         string
+}
+
+// RouteTranslateReport accumulates the per-rule/per-backendRef failures
+// encountered while translating a route. The translator used to just
+// log.Warnw and drop the offending rule or BackendRef; it now also records
+// the failure here so the provider can turn it into RouteParentStatus
+// conditions (ResolvedRefs=False with the proper reason, and Accepted=False
+// when every rule ended up failing) instead of leaving the user with no
+// signal.
+type RouteTranslateReport struct {
+	BackendFailures []BackendRefFailure
+	RuleCount       int
+	AcceptedRules   int
+
+	// HostnameMismatch is set instead of populating any rules when none of
+	// the route's hostnames intersect the parent Listener's hostname. It is
+	// tracked separately from RuleCount==0 (a route with no rules at all)
+	// since both reach Accepted() with zero rules but must report different
+	// reasons.
+	HostnameMismatch bool
+}
+
+func (r *RouteTranslateReport) addBackendFailure(ruleIndex, backendRefIndex int, reason RouteConditionReason, message string) {
+	r.BackendFailures = append(r.BackendFailures, BackendRefFailure{
+		RuleIndex:       ruleIndex,
+		BackendRefIndex: backendRefIndex,
+		Reason:          reason,
+		Message:         message,
+	})
+}
+
+// ResolvedRefs reports whether every BackendRef resolved cleanly.
+func (r *RouteTranslateReport) ResolvedRefs() bool {
+	return len(r.BackendFailures) == 0
+}
+
+// Accepted reports whether at least one rule produced a route, or the route
+// has no rules at all. A hostname mismatch is never accepted.
+func (r *RouteTranslateReport) Accepted() bool {
+	if r.HostnameMismatch {
+		return false
+	}
+	return r.RuleCount == 0 || r.AcceptedRules > 0
+}
@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteTranslateReportAccepted(t *testing.T) {
+	t.Run("zero rules is accepted", func(t *testing.T) {
+		r := &RouteTranslateReport{}
+		assert.True(t, r.Accepted())
+	})
+
+	t.Run("at least one accepted rule is accepted", func(t *testing.T) {
+		r := &RouteTranslateReport{RuleCount: 2, AcceptedRules: 1}
+		assert.True(t, r.Accepted())
+	})
+
+	t.Run("every rule failed is not accepted", func(t *testing.T) {
+		r := &RouteTranslateReport{RuleCount: 2, AcceptedRules: 0}
+		assert.False(t, r.Accepted())
+	})
+
+	t.Run("hostname mismatch is not accepted even with zero rules", func(t *testing.T) {
+		r := &RouteTranslateReport{HostnameMismatch: true}
+		assert.False(t, r.Accepted())
+	})
+}
+
+func TestRouteTranslateReportResolvedRefs(t *testing.T) {
+	t.Run("no failures resolves", func(t *testing.T) {
+		r := &RouteTranslateReport{}
+		assert.True(t, r.ResolvedRefs())
+	})
+
+	t.Run("any backend failure does not resolve", func(t *testing.T) {
+		r := &RouteTranslateReport{}
+		r.addBackendFailure(0, 0, ReasonBackendNotFound, "boom")
+		assert.False(t, r.ResolvedRefs())
+	})
+}
@@ -0,0 +1,36 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+)
+
+// ManagedByLabel marks the APISIX routes and upstreams this package
+// produces, so a startup full-sync can tell them apart from resources
+// owned by ApisixRoute/ApisixUpstream CRDs or other providers.
+const ManagedByLabel = "apisix.apache.org/managed-by-gateway-api"
+
+// Translator is the exported surface other packages in the gateway provider
+// use to turn Gateway API route objects into APISIX resources, so they do
+// not need to depend on the unexported translator type directly.
+type Translator interface {
+	TranslateGatewayHTTPRouteV1Alpha2(httpRoute *gatewayv1alpha2.HTTPRoute, listenerHostname *gatewayv1alpha2.Hostname) (*translation.TranslateContext, *RouteTranslateReport, error)
+	TranslateGatewayTLSRouteV1Alpha2(tlsRoute *gatewayv1alpha2.TLSRoute, listenerHostname *gatewayv1alpha2.Hostname) (*translation.TranslateContext, *RouteTranslateReport, error)
+}
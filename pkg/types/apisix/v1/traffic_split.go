@@ -0,0 +1,38 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package v1
+
+// TrafficSplitConfig is the configuration for APISIX traffic-split plugin.
+type TrafficSplitConfig struct {
+	Rules []TrafficSplitConfigRule `json:"rules,omitempty"`
+}
+
+// TrafficSplitConfigRule is a single rule of the traffic-split plugin,
+// distributing requests across its WeightedUpstreams.
+type TrafficSplitConfigRule struct {
+	WeightedUpstreams []TrafficSplitConfigRuleWeightedUpstream `json:"weighted_upstreams,omitempty"`
+}
+
+// TrafficSplitConfigRuleWeightedUpstream is a single weighted upstream
+// target of a traffic-split rule. Plugins holds plugin configuration that
+// only applies when this upstream is selected, e.g. filters carried by a
+// Gateway API HTTPRoute BackendRef that only apply to that backend.
+type TrafficSplitConfigRuleWeightedUpstream struct {
+	UpstreamID string  `json:"upstream_id,omitempty"`
+	Weight     int     `json:"weight,omitempty"`
+	Plugins    Plugins `json:"plugins,omitempty"`
+}
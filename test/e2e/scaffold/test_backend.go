@@ -16,11 +16,13 @@ package scaffold
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
 	ginkgo "github.com/onsi/ginkgo/v2"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -183,6 +185,28 @@ func (s *Scaffold) newTestBackend() (*corev1.Service, error) {
 	return svc, nil
 }
 
+// GetDeploymentLogs returns the concatenated container logs of every Pod
+// backing deploymentName, matched by the conventional "app: deploymentName"
+// label the test manifests in this file use. It's for tests that need to
+// assert on what a backend actually observed (e.g. a mirrored request)
+// rather than only its HTTP response; a Pod whose logs can't be fetched is
+// skipped rather than failing the whole call.
+func (s *Scaffold) GetDeploymentLogs(deploymentName string) string {
+	pods := k8s.ListPods(s.t, s.kubectlOptions, metav1.ListOptions{
+		LabelSelector: "app=" + deploymentName,
+	})
+
+	var logs strings.Builder
+	for _, pod := range pods {
+		podLogs, err := k8s.GetPodLogsE(s.t, s.kubectlOptions, pod.Name, deploymentName)
+		if err != nil {
+			continue
+		}
+		logs.WriteString(podLogs)
+	}
+	return logs.String()
+}
+
 // NewCoreDNSService creates a new UDP backend for testing.
 func (s *Scaffold) NewCoreDNSService() *corev1.Service {
 	err := k8s.KubectlApplyFromStringE(s.t, s.kubectlOptions, _udpDeployment)
@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/test/e2e/scaffold"
+)
+
+var _ = ginkgo.Describe("suite-gateway: HTTPRoute RequestMirror filter", func() {
+	s := scaffold.NewDefaultScaffold()
+
+	ginkgo.It("mirrors requests to the configured BackendRef", func() {
+		backendSvc, backendPort := s.DefaultHTTPBackend()
+
+		gatewayClass := `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: GatewayClass
+metadata:
+  name: apisix
+spec:
+  controllerName: "apisix.apache.org/gateway-controller"
+`
+		gateway := `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: Gateway
+metadata:
+  name: apisix
+spec:
+  gatewayClassName: apisix
+  listeners:
+    - name: http
+      protocol: HTTP
+      port: 80
+`
+		httpRoute := fmt.Sprintf(`
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: HTTPRoute
+metadata:
+  name: httpbin
+spec:
+  parentRefs:
+    - name: apisix
+  rules:
+    - matches:
+        - path:
+            type: PathPrefix
+            value: /get
+      filters:
+        - type: RequestMirror
+          requestMirror:
+            backendRef:
+              name: %s
+              port: %d
+      backendRefs:
+        - name: %s
+          port: %d
+`, backendSvc, backendPort, backendSvc, backendPort)
+
+		assert.NoError(ginkgo.GinkgoT(), s.CreateResourceFromStringWithNamespace(gatewayClass, ""))
+		assert.NoError(ginkgo.GinkgoT(), s.CreateResourceFromString(gateway))
+		assert.NoError(ginkgo.GinkgoT(), s.CreateResourceFromString(httpRoute))
+
+		// Tag the request so it can be told apart from unrelated traffic in
+		// the backend's logs, and use it to tell a mirrored copy (two
+		// occurrences: the primary request plus the mirror) from a mirror
+		// that silently never fired (one occurrence).
+		marker := fmt.Sprintf("mirror-test-%d", time.Now().UnixNano())
+
+		s.NewAPISIXClient().
+			GET("/get").
+			WithHost("httpbin.org").
+			WithQuery("marker", marker).
+			Expect().
+			Status(http.StatusOK)
+
+		assert.Eventually(ginkgo.GinkgoT(), func() bool {
+			logs := s.GetDeploymentLogs("test-backend-deployment-e2e-test")
+			return strings.Count(logs, marker) >= 2
+		}, 15*time.Second, time.Second, "expected the mirror target to receive a duplicate of the request")
+	})
+})